@@ -1,34 +1,516 @@
 package CourseRegistry
 
 import (
+	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
+	"os"
+	"sort"
+	"sync"
 )
 
 // Student struct
 type Student struct {
-	ID      uint64
-	Name    string
-	Courses []string
+	ID           uint64
+	Name         string
+	Courses      []string
+	AcademicYear uint
+	Master       bool
 }
 
-// Registry struct
+// Course describes a course offered through the catalog, including the
+// constraints EnrollCourse checks before allowing a student to register.
+type Course struct {
+	ID            string
+	Name          string
+	Capacity      int
+	Prerequisites []string
+	MinYear       uint
+	MastersOnly   bool
+}
+
+// CourseCatalog holds the set of courses students can enroll in.
+type CourseCatalog struct {
+	Courses map[string]Course
+}
+
+// SortKey selects the ordering used by ListStudentsSorted.
+type SortKey int
+
+const (
+	SortByID SortKey = iota
+	SortByName
+	SortByCourseCount
+)
+
+// Registry struct. mu guards Students, Catalog, and the lookup indexes.
 type Registry struct {
+	mu       sync.RWMutex
 	Students map[uint64]Student
+	Catalog  CourseCatalog
+
+	nameIndex   map[string]map[uint64]struct{}
+	sortedNames []string
+	courseIndex map[string]map[uint64]struct{}
 }
 
+// Typed errors returned by Registry/Tx methods so callers can branch on the
+// failure reason with errors.Is instead of parsing error strings.
+var (
+	ErrCourseUnknown       = errors.New("course is not in the catalog")
+	ErrCourseFull          = errors.New("course has reached its capacity")
+	ErrPrerequisiteMissing = errors.New("student is missing a prerequisite for this course")
+	ErrYearTooLow          = errors.New("student's academic year is below the course minimum")
+	ErrMastersOnly         = errors.New("course is restricted to masters students")
+	ErrStudentExists       = errors.New("student ID already exists")
+	ErrStudentNotFound     = errors.New("student does not exist")
+)
+
 // NewRegistry creates a new Registry
 func NewRegistry() *Registry {
-	return &Registry{
+	r := &Registry{
 		Students: make(map[uint64]Student),
+		Catalog:  CourseCatalog{Courses: make(map[string]Course)},
+	}
+	r.rebuildIndexes()
+	return r
+}
+
+// rebuildIndexes recomputes the lookup indexes from Students. Callers must
+// hold r.mu for writing.
+func (r *Registry) rebuildIndexes() {
+	r.nameIndex = make(map[string]map[uint64]struct{}, len(r.Students))
+	r.sortedNames = make([]string, 0, len(r.Students))
+	r.courseIndex = make(map[string]map[uint64]struct{})
+
+	for id, student := range r.Students {
+		if r.nameIndex[student.Name] == nil {
+			r.nameIndex[student.Name] = make(map[uint64]struct{})
+		}
+		r.nameIndex[student.Name][id] = struct{}{}
+		r.sortedNames = append(r.sortedNames, student.Name)
+
+		for _, course := range student.Courses {
+			if r.courseIndex[course] == nil {
+				r.courseIndex[course] = make(map[uint64]struct{})
+			}
+			r.courseIndex[course][id] = struct{}{}
+		}
+	}
+
+	sort.Strings(r.sortedNames)
+}
+
+// indexAddStudent incrementally updates the lookup indexes for a newly
+// added student. Callers must hold r.mu for writing.
+func (r *Registry) indexAddStudent(student Student) {
+	if r.nameIndex[student.Name] == nil {
+		r.nameIndex[student.Name] = make(map[uint64]struct{})
+	}
+	r.nameIndex[student.Name][student.ID] = struct{}{}
+
+	pos := sort.SearchStrings(r.sortedNames, student.Name)
+	r.sortedNames = append(r.sortedNames, "")
+	copy(r.sortedNames[pos+1:], r.sortedNames[pos:])
+	r.sortedNames[pos] = student.Name
+
+	for _, course := range student.Courses {
+		if r.courseIndex[course] == nil {
+			r.courseIndex[course] = make(map[uint64]struct{})
+		}
+		r.courseIndex[course][student.ID] = struct{}{}
 	}
 }
 
+// indexRemoveStudent incrementally updates the lookup indexes for a removed
+// student. Callers must hold r.mu for writing.
+func (r *Registry) indexRemoveStudent(student Student) {
+	delete(r.nameIndex[student.Name], student.ID)
+	if len(r.nameIndex[student.Name]) == 0 {
+		delete(r.nameIndex, student.Name)
+	}
+
+	if pos := sort.SearchStrings(r.sortedNames, student.Name); pos < len(r.sortedNames) && r.sortedNames[pos] == student.Name {
+		r.sortedNames = append(r.sortedNames[:pos], r.sortedNames[pos+1:]...)
+	}
+
+	for _, course := range student.Courses {
+		delete(r.courseIndex[course], student.ID)
+	}
+}
+
+// NewRegistryFromFile creates a new Registry and loads it from path if the
+// file exists. A missing file is not an error; the registry simply starts
+// empty, same as NewRegistry.
+func NewRegistryFromFile(path string) (*Registry, error) {
+	registry := NewRegistry()
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return registry, nil
+	}
+
+	if err := registry.LoadFromFile(path); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// SaveToFile serializes the registry to path as JSON, writing atomically so
+// a crash or power loss mid-write cannot corrupt the file on disk. It writes
+// to a temporary file in the same directory, fsyncs it, then renames it
+// over path.
+func (r *Registry) SaveToFile(path string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal registry: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("write temp file: %w", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("sync temp file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("close temp file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("rename temp file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadFromFile replaces the registry's contents with the data stored at
+// path, as previously written by SaveToFile.
+func (r *Registry) LoadFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+
+	loaded := NewRegistry()
+	if err := json.Unmarshal(data, loaded); err != nil {
+		return fmt.Errorf("unmarshal registry: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Students = loaded.Students
+	r.Catalog = loaded.Catalog
+	r.rebuildIndexes()
+	return nil
+}
+
 // AddStudent adds a new student to the registry
 func (r *Registry) AddStudent(student Student) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := addStudent(r.Students, student); err != nil {
+		return err
+	}
+
+	r.indexAddStudent(r.Students[student.ID])
+	return nil
+}
+
+// GetStudent returns the student with studentID, if any.
+func (r *Registry) GetStudent(studentID uint64) (Student, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	student, exists := r.Students[studentID]
+	return student, exists
+}
+
+// RemoveStudent removes a student from the registry entirely.
+func (r *Registry) RemoveStudent(studentID uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	student, exists := r.Students[studentID]
+	if !exists {
+		return fmt.Errorf("remove student %d: %w", studentID, ErrStudentNotFound)
+	}
+
+	delete(r.Students, studentID)
+	r.indexRemoveStudent(student)
+	return nil
+}
+
+// AddCourse adds a course to the catalog
+func (r *Registry) AddCourse(course Course) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return addCourse(r.Catalog.Courses, course)
+}
+
+// RemoveCourse removes a course from the catalog
+func (r *Registry) RemoveCourse(courseID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return removeCourse(r.Catalog.Courses, courseID)
+}
+
+// EnrollCourse enrolls a student in a course, validating the enrollment
+// against the catalog: the course must exist, have room, and the student
+// must meet its prerequisite, year, and masters-only constraints.
+func (r *Registry) EnrollCourse(studentID uint64, courseID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := enrollCourse(r.Students, r.Catalog.Courses, studentID, courseID); err != nil {
+		return err
+	}
+
+	if r.courseIndex[courseID] == nil {
+		r.courseIndex[courseID] = make(map[uint64]struct{})
+	}
+	r.courseIndex[courseID][studentID] = struct{}{}
+	return nil
+}
+
+// DropCourse removes a course from a student's enrollment
+func (r *Registry) DropCourse(studentID uint64, course string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := dropCourse(r.Students, studentID, course); err != nil {
+		return err
+	}
+
+	delete(r.courseIndex[course], studentID)
+	return nil
+}
+
+// ListStudents returns all students as a slice
+func (r *Registry) ListStudents() []Student {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return listStudents(r.Students)
+}
+
+// CoursesCount returns a map with course enrollment statistics
+func (r *Registry) CoursesCount() map[string]int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.courseIndexCounts()
+}
+
+// courseIndexCounts builds a course -> enrollment count map from courseIndex
+func (r *Registry) courseIndexCounts() map[string]int {
+	counts := make(map[string]int, len(r.courseIndex))
+	for course, ids := range r.courseIndex {
+		counts[course] = len(ids)
+	}
+	return counts
+}
+
+// ListStudentsSorted returns all students ordered by by.
+func (r *Registry) ListStudentsSorted(by SortKey) []Student {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	students := listStudents(r.Students)
+
+	switch by {
+	case SortByName:
+		sort.Slice(students, func(i, j int) bool { return students[i].Name < students[j].Name })
+	case SortByCourseCount:
+		sort.Slice(students, func(i, j int) bool { return len(students[i].Courses) < len(students[j].Courses) })
+	default:
+		sort.Slice(students, func(i, j int) bool { return students[i].ID < students[j].ID })
+	}
+
+	return students
+}
+
+// FindStudentByName looks up a student by exact name using sort.SearchStrings
+// against the registry's sorted name index. Names are not required to be
+// unique; if more than one student shares name, the one with the lowest ID
+// is returned.
+func (r *Registry) FindStudentByName(name string) (Student, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pos := sort.SearchStrings(r.sortedNames, name)
+	if pos == len(r.sortedNames) || r.sortedNames[pos] != name {
+		return Student{}, false
+	}
+
+	ids := r.nameIndex[name]
+	if len(ids) == 0 {
+		return Student{}, false
+	}
+
+	var studentID uint64
+	first := true
+	for id := range ids {
+		if first || id < studentID {
+			studentID = id
+			first = false
+		}
+	}
+
+	return r.Students[studentID], true
+}
+
+// SearchStudentsByCourse returns every student enrolled in course
+func (r *Registry) SearchStudentsByCourse(course string) []Student {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := r.courseIndex[course]
+	students := make([]Student, 0, len(ids))
+	for id := range ids {
+		students = append(students, r.Students[id])
+	}
+	return students
+}
+
+// PrintStudents prints all students in the required format
+func (r *Registry) PrintStudents() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	printStudents(listStudents(r.Students))
+}
+
+// PrintCourseStatistics prints course enrollment statistics
+func (r *Registry) PrintCourseStatistics() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	printCourseStatistics(r.courseIndexCounts(), r.Catalog.Courses)
+}
+
+// Tx stages mutations made during a Registry.Do call against shadow copies
+// of Students and the course catalog, so concurrent readers never observe
+// partially applied state. Tx exposes Registry's mutating operations plus
+// ListStudents and CoursesCount; GetStudent, ListStudentsSorted,
+// FindStudentByName, SearchStudentsByCourse and the Print methods are not
+// available on Tx. A nil return from the Do callback commits the shadow
+// copies back onto the Registry, and any error leaves the Registry untouched.
+type Tx struct {
+	students map[uint64]Student
+	courses  map[string]Course
+}
+
+// AddStudent stages a student creation
+func (t *Tx) AddStudent(student Student) error {
+	return addStudent(t.students, student)
+}
+
+// RemoveStudent stages a student removal
+func (t *Tx) RemoveStudent(studentID uint64) error {
+	return removeStudent(t.students, studentID)
+}
+
+// AddCourse stages a catalog course creation
+func (t *Tx) AddCourse(course Course) error {
+	return addCourse(t.courses, course)
+}
+
+// RemoveCourse stages a catalog course removal
+func (t *Tx) RemoveCourse(courseID string) error {
+	return removeCourse(t.courses, courseID)
+}
+
+// EnrollCourse stages a course enrollment, validated against the same
+// catalog rules as Registry.EnrollCourse.
+func (t *Tx) EnrollCourse(studentID uint64, courseID string) error {
+	return enrollCourse(t.students, t.courses, studentID, courseID)
+}
+
+// DropCourse stages a course removal from a student's enrollment
+func (t *Tx) DropCourse(studentID uint64, course string) error {
+	return dropCourse(t.students, studentID, course)
+}
+
+// ListStudents returns the staged students as a slice
+func (t *Tx) ListStudents() []Student {
+	return listStudents(t.students)
+}
+
+// CoursesCount returns course enrollment statistics for the staged students.
+func (t *Tx) CoursesCount() map[string]int {
+	return coursesCount(t.students)
+}
+
+// Do runs fn against a transaction staged on shadow copies of the registry.
+// A nil return commits the copies back onto the registry; a non-nil return
+// leaves the registry untouched.
+func (r *Registry) Do(fn func(txn *Tx) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	txn := &Tx{
+		students: copyStudents(r.Students),
+		courses:  copyCourses(r.Catalog.Courses),
+	}
+
+	if err := fn(txn); err != nil {
+		return err
+	}
+
+	r.Students = txn.students
+	r.Catalog.Courses = txn.courses
+	r.rebuildIndexes()
+	return nil
+}
+
+// copyStudents returns a shallow copy of students for use as a Tx shadow map.
+func copyStudents(students map[uint64]Student) map[uint64]Student {
+	copied := make(map[uint64]Student, len(students))
+	for id, student := range students {
+		copied[id] = student
+	}
+	return copied
+}
+
+// copyCourses returns a shallow copy of catalog for use as a Tx shadow map.
+func copyCourses(catalog map[string]Course) map[string]Course {
+	copied := make(map[string]Course, len(catalog))
+	for id, course := range catalog {
+		copied[id] = course
+	}
+	return copied
+}
+
+// addStudent applies student creation to students. It is shared by the
+// locking Registry methods and Tx, which call it against the live map and
+// a shadow map respectively.
+func addStudent(students map[uint64]Student, student Student) error {
 	// Check if student ID already exists
-	if _, exists := r.Students[student.ID]; exists {
-		return errors.New("student ID already exists")
+	if _, exists := students[student.ID]; exists {
+		return fmt.Errorf("add student %d: %w", student.ID, ErrStudentExists)
 	}
 
 	// Check if name is empty
@@ -42,42 +524,124 @@ func (r *Registry) AddStudent(student Student) error {
 	}
 
 	// Add student to registry
-	r.Students[student.ID] = student
+	students[student.ID] = student
+	return nil
+}
+
+// removeStudent applies student removal to students.
+func removeStudent(students map[uint64]Student, studentID uint64) error {
+	if _, exists := students[studentID]; !exists {
+		return fmt.Errorf("remove student %d: %w", studentID, ErrStudentNotFound)
+	}
+
+	delete(students, studentID)
+	return nil
+}
+
+// addCourse applies catalog course creation to catalog.
+func addCourse(catalog map[string]Course, course Course) error {
+	// Check if course ID already exists
+	if _, exists := catalog[course.ID]; exists {
+		return errors.New("course ID already exists")
+	}
+
+	// Check if course ID is empty
+	if course.ID == "" {
+		return errors.New("course ID cannot be empty")
+	}
+
+	catalog[course.ID] = course
 	return nil
 }
 
-// EnrollCourse enrolls a student in a course
-func (r *Registry) EnrollCourse(studentID uint64, course string) error {
+// removeCourse applies catalog course removal to catalog.
+func removeCourse(catalog map[string]Course, courseID string) error {
+	if _, exists := catalog[courseID]; !exists {
+		return errors.New("course not found in catalog")
+	}
+
+	delete(catalog, courseID)
+	return nil
+}
+
+// courseEnrollmentCount counts how many students are currently enrolled in
+// courseID, used to enforce Course.Capacity.
+func courseEnrollmentCount(students map[uint64]Student, courseID string) int {
+	count := 0
+	for _, student := range students {
+		for _, c := range student.Courses {
+			if c == courseID {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// hasCourse reports whether a student's transcript already includes courseID.
+func hasCourse(student Student, courseID string) bool {
+	for _, c := range student.Courses {
+		if c == courseID {
+			return true
+		}
+	}
+	return false
+}
+
+// enrollCourse applies course enrollment to students, validating against
+// catalog.
+func enrollCourse(students map[uint64]Student, catalog map[string]Course, studentID uint64, courseID string) error {
 	// Check if student exists
-	student, exists := r.Students[studentID]
+	student, exists := students[studentID]
 	if !exists {
-		return errors.New("student does not exist")
+		return fmt.Errorf("enroll course: student %d: %w", studentID, ErrStudentNotFound)
 	}
 
 	// Check if course name is empty
-	if course == "" {
+	if courseID == "" {
 		return errors.New("course name cannot be empty")
 	}
 
+	course, exists := catalog[courseID]
+	if !exists {
+		return fmt.Errorf("enroll course %q: %w", courseID, ErrCourseUnknown)
+	}
+
 	// Check if student is already enrolled in the course
-	for _, c := range student.Courses {
-		if c == course {
-			return errors.New("student is already enrolled in this course")
+	if hasCourse(student, courseID) {
+		return errors.New("student is already enrolled in this course")
+	}
+
+	if course.Capacity > 0 && courseEnrollmentCount(students, courseID) >= course.Capacity {
+		return fmt.Errorf("enroll course %q: %w", courseID, ErrCourseFull)
+	}
+
+	for _, prereq := range course.Prerequisites {
+		if !hasCourse(student, prereq) {
+			return fmt.Errorf("enroll course %q: missing prerequisite %q: %w", courseID, prereq, ErrPrerequisiteMissing)
 		}
 	}
 
+	if student.AcademicYear < course.MinYear {
+		return fmt.Errorf("enroll course %q: %w", courseID, ErrYearTooLow)
+	}
+
+	if course.MastersOnly && !student.Master {
+		return fmt.Errorf("enroll course %q: %w", courseID, ErrMastersOnly)
+	}
+
 	// Add course to student's course list
-	student.Courses = append(student.Courses, course)
-	r.Students[studentID] = student
+	student.Courses = append(student.Courses, courseID)
+	students[studentID] = student
 	return nil
 }
 
-// RemoveCourse removes a course from a student's enrollment
-func (r *Registry) RemoveCourse(studentID uint64, course string) error {
+// dropCourse applies course removal to a student's enrollment in students.
+func dropCourse(students map[uint64]Student, studentID uint64, course string) error {
 	// Check if student exists
-	student, exists := r.Students[studentID]
+	student, exists := students[studentID]
 	if !exists {
-		return errors.New("student does not exist")
+		return fmt.Errorf("drop course: student %d: %w", studentID, ErrStudentNotFound)
 	}
 
 	// Find and remove the course
@@ -97,27 +661,27 @@ func (r *Registry) RemoveCourse(studentID uint64, course string) error {
 
 	// Update student's courses
 	student.Courses = newCourses
-	r.Students[studentID] = student
+	students[studentID] = student
 	return nil
 }
 
-// ListStudents returns all students as a slice
-func (r *Registry) ListStudents() []Student {
-	students := make([]Student, 0, len(r.Students))
+// listStudents returns all students as a slice
+func listStudents(students map[uint64]Student) []Student {
+	result := make([]Student, 0, len(students))
 
-	for _, student := range r.Students {
-		students = append(students, student)
+	for _, student := range students {
+		result = append(result, student)
 	}
 
-	return students
+	return result
 }
 
-// CoursesCount returns a map with course enrollment statistics
-func (r *Registry) CoursesCount() map[string]int {
+// coursesCount returns a map with course enrollment statistics
+func coursesCount(students map[uint64]Student) map[string]int {
 	courseCount := make(map[string]int)
 
 	// Count enrollments for each course
-	for _, student := range r.Students {
+	for _, student := range students {
 		for _, course := range student.Courses {
 			courseCount[course]++
 		}
@@ -126,10 +690,8 @@ func (r *Registry) CoursesCount() map[string]int {
 	return courseCount
 }
 
-// PrintStudents prints all students in the required format
-func (r *Registry) PrintStudents() {
-	students := r.ListStudents()
-
+// printStudents prints students in the required format
+func printStudents(students []Student) {
 	if len(students) == 0 {
 		fmt.Println("No students in registry")
 		return
@@ -142,10 +704,10 @@ func (r *Registry) PrintStudents() {
 	}
 }
 
-// PrintCourseStatistics prints course enrollment statistics
-func (r *Registry) PrintCourseStatistics() {
-	courseStats := r.CoursesCount()
-
+// printCourseStatistics prints courseStats, showing enrolled/capacity for
+// any course still present in catalog with a positive Capacity; other
+// courses show the enrolled count only.
+func printCourseStatistics(courseStats map[string]int, catalog map[string]Course) {
 	if len(courseStats) == 0 {
 		fmt.Println("No course enrollments")
 		return
@@ -153,32 +715,68 @@ func (r *Registry) PrintCourseStatistics() {
 
 	fmt.Println("\n=== Course Enrollment Statistics ===")
 	for course, count := range courseStats {
-		fmt.Printf("%s â†’ %d\n", course, count)
+		if catalogCourse, exists := catalog[course]; exists && catalogCourse.Capacity > 0 {
+			fmt.Printf("%s -> %d/%d\n", course, count, catalogCourse.Capacity)
+		} else {
+			fmt.Printf("%s -> %d\n", course, count)
+		}
 	}
 }
 
-// RunCourseRegistry provides console interface
+// ServeFunc starts r as an HTTP server and blocks until it exits. It is nil
+// until something sets it, which is normally a side effect of importing
+// CourseRegistry/server (see that package's init). Keeping the hook here,
+// rather than importing the server package directly, avoids a CourseRegistry
+// <-> CourseRegistry/server import cycle while still letting --serve work
+// whenever the server package is linked in.
+var ServeFunc func(r *Registry) error
+
+// RunCourseRegistry provides console interface. With --serve, and the
+// server package imported for its side effect, it exposes the registry over
+// HTTP instead of running the console menu.
 func RunCourseRegistry() {
+	serve := flag.Bool("serve", false, "expose the registry over HTTP instead of the console menu")
+	flag.Parse()
+
 	registry := NewRegistry()
 
 	// Add initial test data
-	registry.AddStudent(Student{ID: 1, Name: "Alice", Courses: []string{"Go", "Databases"}})
-	registry.AddStudent(Student{ID: 2, Name: "Bob", Courses: []string{"Go"}})
-	registry.AddStudent(Student{ID: 3, Name: "Charlie", Courses: []string{}})
+	registry.AddCourse(Course{ID: "Go", Name: "Go Programming", Capacity: 2})
+	registry.AddCourse(Course{ID: "Databases", Name: "Databases", Capacity: 10, Prerequisites: []string{"Go"}})
+
+	registry.AddStudent(Student{ID: 1, Name: "Alice", Courses: []string{"Go", "Databases"}, AcademicYear: 2})
+	registry.AddStudent(Student{ID: 2, Name: "Bob", Courses: []string{"Go"}, AcademicYear: 1})
+	registry.AddStudent(Student{ID: 3, Name: "Charlie", Courses: []string{}, AcademicYear: 1})
 
 	fmt.Println("Initial test data loaded:")
 	registry.PrintStudents()
 	registry.PrintCourseStatistics()
 
+	if *serve {
+		if ServeFunc == nil {
+			fmt.Println("--serve requires the CourseRegistry/server package to be imported")
+			return
+		}
+
+		if err := ServeFunc(registry); err != nil {
+			fmt.Printf("server error: %v\n", err)
+		}
+		return
+	}
+
 	for {
 		fmt.Println("\n=== Course Registry System ===")
 		fmt.Println("1. Add Student")
-		fmt.Println("2. Enroll Course")
-		fmt.Println("3. Remove Course")
-		fmt.Println("4. List Students")
-		fmt.Println("5. Course Statistics")
-		fmt.Println("6. Exit")
-		fmt.Print("Select option (1-6): ")
+		fmt.Println("2. Add Course")
+		fmt.Println("3. Enroll Course")
+		fmt.Println("4. Drop Course")
+		fmt.Println("5. Remove Course From Catalog")
+		fmt.Println("6. List Students")
+		fmt.Println("7. Course Statistics")
+		fmt.Println("8. Save Registry")
+		fmt.Println("9. Load Registry")
+		fmt.Println("10. Exit")
+		fmt.Print("Select option (1-10): ")
 
 		var choice int
 		fmt.Scan(&choice)
@@ -187,16 +785,24 @@ func RunCourseRegistry() {
 		case 1:
 			var id uint64
 			var name string
+			var year uint
+			var master bool
 
 			fmt.Print("Enter student ID: ")
 			fmt.Scan(&id)
 			fmt.Print("Enter student name: ")
 			fmt.Scan(&name)
+			fmt.Print("Enter academic year: ")
+			fmt.Scan(&year)
+			fmt.Print("Is masters student (true/false): ")
+			fmt.Scan(&master)
 
 			student := Student{
-				ID:      id,
-				Name:    name,
-				Courses: []string{},
+				ID:           id,
+				Name:         name,
+				Courses:      []string{},
+				AcademicYear: year,
+				Master:       master,
 			}
 
 			if err := registry.AddStudent(student); err != nil {
@@ -206,6 +812,37 @@ func RunCourseRegistry() {
 			}
 
 		case 2:
+			var id, name string
+			var capacity int
+			var minYear uint
+			var mastersOnly bool
+
+			fmt.Print("Enter course ID: ")
+			fmt.Scan(&id)
+			fmt.Print("Enter course name: ")
+			fmt.Scan(&name)
+			fmt.Print("Enter capacity: ")
+			fmt.Scan(&capacity)
+			fmt.Print("Enter minimum academic year: ")
+			fmt.Scan(&minYear)
+			fmt.Print("Masters only (true/false): ")
+			fmt.Scan(&mastersOnly)
+
+			course := Course{
+				ID:          id,
+				Name:        name,
+				Capacity:    capacity,
+				MinYear:     minYear,
+				MastersOnly: mastersOnly,
+			}
+
+			if err := registry.AddCourse(course); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			} else {
+				fmt.Println("Course added to catalog successfully!")
+			}
+
+		case 3:
 			var studentID uint64
 			var course string
 
@@ -220,7 +857,7 @@ func RunCourseRegistry() {
 				fmt.Println("Course enrolled successfully!")
 			}
 
-		case 3:
+		case 4:
 			var studentID uint64
 			var course string
 
@@ -229,24 +866,60 @@ func RunCourseRegistry() {
 			fmt.Print("Enter course name: ")
 			fmt.Scan(&course)
 
-			if err := registry.RemoveCourse(studentID, course); err != nil {
+			if err := registry.DropCourse(studentID, course); err != nil {
 				fmt.Printf("Error: %v\n", err)
 			} else {
 				fmt.Println("Course removed successfully!")
 			}
 
-		case 4:
+		case 5:
+			var courseID string
+
+			fmt.Print("Enter course ID to remove from catalog: ")
+			fmt.Scan(&courseID)
+
+			if err := registry.RemoveCourse(courseID); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			} else {
+				fmt.Println("Course removed from catalog successfully!")
+			}
+
+		case 6:
 			registry.PrintStudents()
 
-		case 5:
+		case 7:
 			registry.PrintCourseStatistics()
 
-		case 6:
+		case 8:
+			var path string
+
+			fmt.Print("Enter file path to save: ")
+			fmt.Scan(&path)
+
+			if err := registry.SaveToFile(path); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			} else {
+				fmt.Println("Registry saved successfully!")
+			}
+
+		case 9:
+			var path string
+
+			fmt.Print("Enter file path to load: ")
+			fmt.Scan(&path)
+
+			if err := registry.LoadFromFile(path); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			} else {
+				fmt.Println("Registry loaded successfully!")
+			}
+
+		case 10:
 			fmt.Println("Exiting Course Registry System...")
 			return
 
 		default:
-			fmt.Println("Invalid choice! Please select 1-6.")
+			fmt.Println("Invalid choice! Please select 1-10.")
 		}
 	}
 }
@@ -0,0 +1,123 @@
+package CourseRegistry
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEnrollCourseRejections(t *testing.T) {
+	tests := []struct {
+		name      string
+		preEnroll []Student
+		student   Student
+		course    Course
+		courseID  string
+		wantErr   error
+	}{
+		{
+			name:     "unknown course",
+			student:  Student{ID: 1, Name: "Alice", AcademicYear: 2},
+			course:   Course{ID: "Go"},
+			courseID: "Databases",
+			wantErr:  ErrCourseUnknown,
+		},
+		{
+			name:      "course full",
+			preEnroll: []Student{{ID: 2, Name: "Bob", AcademicYear: 2, Courses: []string{"Go"}}},
+			student:   Student{ID: 1, Name: "Alice", AcademicYear: 2},
+			course:    Course{ID: "Go", Capacity: 1},
+			courseID:  "Go",
+			wantErr:   ErrCourseFull,
+		},
+		{
+			name:     "missing prerequisite",
+			student:  Student{ID: 1, Name: "Alice", AcademicYear: 2},
+			course:   Course{ID: "Databases", Capacity: 1, Prerequisites: []string{"Go"}},
+			courseID: "Databases",
+			wantErr:  ErrPrerequisiteMissing,
+		},
+		{
+			name:     "year too low",
+			student:  Student{ID: 1, Name: "Alice", AcademicYear: 1},
+			course:   Course{ID: "Databases", Capacity: 1, MinYear: 2},
+			courseID: "Databases",
+			wantErr:  ErrYearTooLow,
+		},
+		{
+			name:     "masters only",
+			student:  Student{ID: 1, Name: "Alice", AcademicYear: 3},
+			course:   Course{ID: "Databases", Capacity: 1, MastersOnly: true},
+			courseID: "Databases",
+			wantErr:  ErrMastersOnly,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+			r.AddCourse(tt.course)
+			for _, s := range tt.preEnroll {
+				r.AddStudent(s)
+			}
+			r.AddStudent(tt.student)
+
+			err := r.EnrollCourse(tt.student.ID, tt.courseID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("EnrollCourse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnrollCourseSuccess(t *testing.T) {
+	r := NewRegistry()
+	r.AddCourse(Course{ID: "Go", Capacity: 1})
+	r.AddStudent(Student{ID: 1, Name: "Alice", AcademicYear: 1})
+
+	if err := r.EnrollCourse(1, "Go"); err != nil {
+		t.Fatalf("EnrollCourse() error = %v, want nil", err)
+	}
+
+	if got := r.CoursesCount()["Go"]; got != 1 {
+		t.Fatalf("CoursesCount()[\"Go\"] = %d, want 1", got)
+	}
+}
+
+func TestDoRollsBackOnError(t *testing.T) {
+	r := NewRegistry()
+	r.AddCourse(Course{ID: "Go", Capacity: 1})
+	r.AddStudent(Student{ID: 1, Name: "Alice", AcademicYear: 1})
+
+	wantErr := errors.New("boom")
+	err := r.Do(func(txn *Tx) error {
+		if err := txn.AddStudent(Student{ID: 2, Name: "Bob", AcademicYear: 1}); err != nil {
+			return err
+		}
+		return wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Do() error = %v, want %v", err, wantErr)
+	}
+
+	if _, exists := r.GetStudent(2); exists {
+		t.Fatal("Do() left a staged student committed after returning an error")
+	}
+}
+
+func TestDoCommitsOnSuccess(t *testing.T) {
+	r := NewRegistry()
+	r.AddCourse(Course{ID: "Go", Capacity: 1})
+	r.AddStudent(Student{ID: 1, Name: "Alice", AcademicYear: 1})
+
+	err := r.Do(func(txn *Tx) error {
+		return txn.AddStudent(Student{ID: 2, Name: "Bob", AcademicYear: 1})
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+
+	if _, exists := r.GetStudent(2); !exists {
+		t.Fatal("Do() did not commit a staged student after returning nil")
+	}
+}
@@ -0,0 +1,184 @@
+// Package server exposes a CourseRegistry.Registry over HTTP, so the same
+// registry can be driven as a backend service instead of only through the
+// console demo in RunCourseRegistry.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	courseregistry "assignment1_defence/CourseRegistry"
+)
+
+func init() {
+	courseregistry.ServeFunc = serve
+}
+
+// serve starts r as an HTTP server on :8080 and blocks until it exits. It
+// backs CourseRegistry.ServeFunc, which RunCourseRegistry calls for --serve.
+func serve(r *courseregistry.Registry) error {
+	return NewServer(r).ListenAndServe()
+}
+
+// NewServer builds an *http.Server that exposes r's students and course
+// statistics over HTTP. Addr is set to :8080; the caller may change it
+// before calling ListenAndServe.
+func NewServer(r *courseregistry.Registry) *http.Server {
+	h := &handler{registry: r}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/students", h.handleStudents)
+	mux.HandleFunc("/students/", h.handleStudent)
+	mux.HandleFunc("/courses/stats", h.handleCourseStats)
+
+	return &http.Server{Addr: ":8080", Handler: mux}
+}
+
+// handler serves the registry's HTTP routes.
+type handler struct {
+	registry *courseregistry.Registry
+}
+
+// handleStudents serves GET /students and POST /students.
+func (h *handler) handleStudents(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, h.registry.ListStudents())
+
+	case http.MethodPost:
+		var student courseregistry.Student
+		if err := json.NewDecoder(r.Body).Decode(&student); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := h.registry.AddStudent(student); err != nil {
+			writeError(w, statusFor(err), err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, student)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleStudent serves /students/{id}, /students/{id}/courses (POST to
+// enroll) and /students/{id}/courses/{course} (DELETE to drop).
+func (h *handler) handleStudent(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/students/"), "/"), "/")
+	if len(segments) == 0 || segments[0] == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	studentID, err := strconv.ParseUint(segments[0], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		h.handleStudentByID(w, r, studentID)
+
+	case len(segments) == 2 && segments[1] == "courses" && r.Method == http.MethodPost:
+		h.handleEnrollCourse(w, r, studentID)
+
+	case len(segments) == 3 && segments[1] == "courses" && r.Method == http.MethodDelete:
+		h.handleDropCourse(w, studentID, segments[2])
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// handleStudentByID serves GET and DELETE /students/{id}.
+func (h *handler) handleStudentByID(w http.ResponseWriter, r *http.Request, studentID uint64) {
+	switch r.Method {
+	case http.MethodGet:
+		student, exists := h.registry.GetStudent(studentID)
+		if !exists {
+			writeError(w, http.StatusNotFound, courseregistry.ErrStudentNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, student)
+
+	case http.MethodDelete:
+		if err := h.registry.RemoveStudent(studentID); err != nil {
+			writeError(w, statusFor(err), err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleEnrollCourse serves POST /students/{id}/courses.
+func (h *handler) handleEnrollCourse(w http.ResponseWriter, r *http.Request, studentID uint64) {
+	var body struct {
+		Course string `json:"course"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.registry.EnrollCourse(studentID, body.Course); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// handleDropCourse serves DELETE /students/{id}/courses/{course}.
+func (h *handler) handleDropCourse(w http.ResponseWriter, studentID uint64, course string) {
+	if err := h.registry.DropCourse(studentID, course); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleCourseStats serves GET /courses/stats.
+func (h *handler) handleCourseStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.registry.CoursesCount())
+}
+
+// statusFor maps a Registry error to the status code the routes document:
+// 409 for a duplicate ID, 404 for a missing student, 400 for anything else
+// (validation, capacity, prerequisites, unknown course, ...).
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, courseregistry.ErrStudentExists):
+		return http.StatusConflict
+	case errors.Is(err, courseregistry.ErrStudentNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}